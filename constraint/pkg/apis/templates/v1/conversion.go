@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"github.com/open-policy-agent/frameworks/constraint/pkg/core/templates"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+)
+
+// Convert_v1_Validation_To_templates_Validation converts the versioned OpenAPIV3Schema, which is
+// expressed in terms of the v1 CustomResourceDefinition schema, to the internal representation
+// used by core/templates.
+func Convert_v1_Validation_To_templates_Validation(in *Validation, out *templates.Validation, s conversion.Scope) error {
+	if in.OpenAPIV3Schema != nil {
+		out.OpenAPIV3Schema = new(apiextensions.JSONSchemaProps)
+		if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(in.OpenAPIV3Schema, out.OpenAPIV3Schema, s); err != nil {
+			return err
+		}
+	} else {
+		out.OpenAPIV3Schema = nil
+	}
+	return nil
+}
+
+// Convert_templates_Validation_To_v1_Validation converts the internal OpenAPIV3Schema back into
+// the v1 CustomResourceDefinition schema representation.
+func Convert_templates_Validation_To_v1_Validation(in *templates.Validation, out *Validation, s conversion.Scope) error {
+	if in.OpenAPIV3Schema != nil {
+		out.OpenAPIV3Schema = new(apiextensionsv1.JSONSchemaProps)
+		if err := apiextensionsv1.Convert_apiextensions_JSONSchemaProps_To_v1_JSONSchemaProps(in.OpenAPIV3Schema, out.OpenAPIV3Schema, s); err != nil {
+			return err
+		}
+	} else {
+		out.OpenAPIV3Schema = nil
+	}
+	return nil
+}
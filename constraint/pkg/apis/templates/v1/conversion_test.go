@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/open-policy-agent/frameworks/constraint/pkg/core/templates"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// TestConstraintTemplateRoundTrip exercises the hand-written/generated conversion functions in
+// both directions, verifying that converting a v1 ConstraintTemplate to the internal
+// core/templates representation and back produces an equivalent object.
+func TestConstraintTemplateRoundTrip(t *testing.T) {
+	in := &ConstraintTemplate{
+		Spec: ConstraintTemplateSpec{
+			CRD: CRD{
+				Spec: CRDSpec{
+					Names: CRDNames{Kind: "K8sRequiredLabels"},
+					Validation: &Validation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+				},
+			},
+			Targets: map[string]Target{
+				"admission.k8s.gatekeeper.sh": {
+					Target: "admission.k8s.gatekeeper.sh",
+					Rego:   "package foo\n\nviolation[{\"msg\": msg}] { false; msg := \"\" }",
+				},
+			},
+			EnforcementActions: []string{"deny", "dryrun"},
+		},
+	}
+
+	internal := &templates.ConstraintTemplate{}
+	if err := Convert_v1_ConstraintTemplate_To_templates_ConstraintTemplate(in, internal, nil); err != nil {
+		t.Fatalf("converting to internal representation: %v", err)
+	}
+
+	out := &ConstraintTemplate{}
+	if err := Convert_templates_ConstraintTemplate_To_v1_ConstraintTemplate(internal, out, nil); err != nil {
+		t.Fatalf("converting back from internal representation: %v", err)
+	}
+
+	if !reflect.DeepEqual(in.Spec.CRD.Spec.Names, out.Spec.CRD.Spec.Names) {
+		t.Errorf("CRD names did not round-trip: got %+v, want %+v", out.Spec.CRD.Spec.Names, in.Spec.CRD.Spec.Names)
+	}
+	if !reflect.DeepEqual(in.Spec.Targets, out.Spec.Targets) {
+		t.Errorf("targets did not round-trip: got %+v, want %+v", out.Spec.Targets, in.Spec.Targets)
+	}
+	if !reflect.DeepEqual(in.Spec.EnforcementActions, out.Spec.EnforcementActions) {
+		t.Errorf("enforcementActions did not round-trip: got %v, want %v", out.Spec.EnforcementActions, in.Spec.EnforcementActions)
+	}
+	if out.Spec.CRD.Spec.Validation == nil || out.Spec.CRD.Spec.Validation.OpenAPIV3Schema == nil {
+		t.Fatalf("validation schema did not round-trip: got %+v", out.Spec.CRD.Spec.Validation)
+	}
+	if out.Spec.CRD.Spec.Validation.OpenAPIV3Schema.Type != "object" {
+		t.Errorf("schema type did not round-trip: got %q, want %q", out.Spec.CRD.Spec.Validation.OpenAPIV3Schema.Type, "object")
+	}
+}
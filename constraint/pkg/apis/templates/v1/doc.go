@@ -0,0 +1,21 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1 is the first storage version of the ConstraintTemplate API, graduated out of
+// v1alpha1/v1beta1. It follows the same round-trip-to-internal pattern used by core Kubernetes
+// APIs that have gone through a similar graduation.
+// +k8s:deepcopy-gen=package,register
+// +groupName=templates.gatekeeper.sh
+package v1
@@ -0,0 +1,37 @@
+package v1alpha1
+
+import (
+	"github.com/open-policy-agent/frameworks/constraint/pkg/core/templates"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/conversion"
+)
+
+// Convert_v1alpha1_Validation_To_templates_Validation converts the versioned OpenAPIV3Schema,
+// expressed in terms of the v1beta1 CustomResourceDefinition schema, to the internal
+// representation used by core/templates.
+func Convert_v1alpha1_Validation_To_templates_Validation(in *Validation, out *templates.Validation, s conversion.Scope) error {
+	if in.OpenAPIV3Schema != nil {
+		out.OpenAPIV3Schema = new(apiextensions.JSONSchemaProps)
+		if err := apiextensionsv1beta1.Convert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(in.OpenAPIV3Schema, out.OpenAPIV3Schema, s); err != nil {
+			return err
+		}
+	} else {
+		out.OpenAPIV3Schema = nil
+	}
+	return nil
+}
+
+// Convert_templates_Validation_To_v1alpha1_Validation converts the internal OpenAPIV3Schema back
+// into the v1beta1 CustomResourceDefinition schema representation used by v1alpha1.
+func Convert_templates_Validation_To_v1alpha1_Validation(in *templates.Validation, out *Validation, s conversion.Scope) error {
+	if in.OpenAPIV3Schema != nil {
+		out.OpenAPIV3Schema = new(apiextensionsv1beta1.JSONSchemaProps)
+		if err := apiextensionsv1beta1.Convert_apiextensions_JSONSchemaProps_To_v1beta1_JSONSchemaProps(in.OpenAPIV3Schema, out.OpenAPIV3Schema, s); err != nil {
+			return err
+		}
+	} else {
+		out.OpenAPIV3Schema = nil
+	}
+	return nil
+}
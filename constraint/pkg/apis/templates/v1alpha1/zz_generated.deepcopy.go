@@ -149,6 +149,11 @@ func (in *ConstraintTemplateSpec) DeepCopyInto(out *ConstraintTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.EnforcementActions != nil {
+		in, out := &in.EnforcementActions, &out.EnforcementActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
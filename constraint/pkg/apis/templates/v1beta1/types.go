@@ -0,0 +1,71 @@
+package v1beta1
+
+import (
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConstraintTemplate is the Schema for the constrainttemplates API
+type ConstraintTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConstraintTemplateSpec   `json:"spec,omitempty"`
+	Status ConstraintTemplateStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConstraintTemplateList contains a list of ConstraintTemplate
+type ConstraintTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConstraintTemplate `json:"items"`
+}
+
+// ConstraintTemplateSpec defines the desired state of ConstraintTemplate
+type ConstraintTemplateSpec struct {
+	CRD     CRD               `json:"crd,omitempty"`
+	Targets map[string]Target `json:"targets,omitempty"`
+	// EnforcementActions lists the enforcementAction values constraints created from this
+	// ConstraintTemplate are allowed to use (e.g. "deny", "dryrun", "warn", "scoped"). The CRD
+	// emitted for the template enforces this as an OpenAPI enum, and the first entry is used as
+	// the default when a constraint doesn't set spec.enforcementAction.
+	EnforcementActions []string `json:"enforcementActions,omitempty"`
+}
+
+// CRD guides the construction of the CRD that will be created from this ConstraintTemplate
+type CRD struct {
+	Spec CRDSpec `json:"spec,omitempty"`
+}
+
+// CRDSpec describes the CRD that will be created from this ConstraintTemplate
+type CRDSpec struct {
+	Names      CRDNames    `json:"names,omitempty"`
+	Validation *Validation `json:"validation,omitempty"`
+}
+
+// CRDNames are the names used to describe the CRD
+type CRDNames struct {
+	Kind string `json:"kind,omitempty"`
+}
+
+// Validation describes the schema used to validate constraints created as a result of this
+// ConstraintTemplate
+type Validation struct {
+	OpenAPIV3Schema *apiextensionsv1beta1.JSONSchemaProps `json:"openAPIV3Schema,omitempty"`
+}
+
+// Target describes the target that this ConstraintTemplate applies to
+type Target struct {
+	Target string `json:"target,omitempty"`
+	Rego   string `json:"rego,omitempty"`
+}
+
+// ConstraintTemplateStatus defines the observed state of ConstraintTemplate
+type ConstraintTemplateStatus struct {
+	Created bool `json:"created,omitempty"`
+}
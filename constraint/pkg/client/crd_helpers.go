@@ -3,14 +3,21 @@ package client
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/open-policy-agent/frameworks/constraint/pkg/apis/templates/v1"
 	"github.com/open-policy-agent/frameworks/constraint/pkg/apis/templates/v1alpha1"
 	"github.com/open-policy-agent/frameworks/constraint/pkg/apis/templates/v1beta1"
 	"github.com/open-policy-agent/frameworks/constraint/pkg/core/templates"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apiextensionsvalidation "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/validation"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	structurallisttype "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/listtype"
+	structuralpruning "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -21,13 +28,12 @@ import (
 var supportedVersions = map[string]bool{
 	v1alpha1.SchemeGroupVersion.Version: true,
 	v1beta1.SchemeGroupVersion.Version:  true,
+	v1.SchemeGroupVersion.Version:       true,
 }
 
 // validateTargets ensures that the targets field has the appropriate values
 func validateTargets(templ *templates.ConstraintTemplate) error {
-	if len(templ.Spec.Targets) > 1 {
-		return errors.New("Multi-target templates are not currently supported")
-	} else if templ.Spec.Targets == nil {
+	if templ.Spec.Targets == nil {
 		return errors.New(`Field "targets" not specified in ConstraintTemplate spec`)
 	} else if len(templ.Spec.Targets) == 0 {
 		return errors.New("No targets specified. ConstraintTemplate must specify one target")
@@ -35,23 +41,66 @@ func validateTargets(templ *templates.ConstraintTemplate) error {
 	return nil
 }
 
-// createSchema combines the schema of the match target and the ConstraintTemplate parameters
-// to form the schema of the actual constraint resource
-func (h *crdHelper) createSchema(templ *templates.ConstraintTemplate, target MatchSchemaProvider) (*apiextensions.JSONSchemaProps, error) {
+// createSchema combines the schemas of every match target and the ConstraintTemplate parameters
+// to form the schema of the actual constraint resource. A single-target template keeps the
+// historical shape of "match" being that target's schema directly. A multi-target template
+// instead gives each target its own branch of a "oneOf" keyed by target handler name, so that a
+// constraint can populate the match block of exactly one of its template's targets.
+func (h *crdHelper) createSchema(templ *templates.ConstraintTemplate, targets map[string]MatchSchemaProvider) (*apiextensions.JSONSchemaProps, error) {
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var matchSchema apiextensions.JSONSchemaProps
+	if len(names) == 1 {
+		matchSchema = targets[names[0]].MatchSchema()
+	} else {
+		matchSchemas := make([]apiextensions.JSONSchemaProps, 0, len(names))
+		for _, name := range names {
+			matchSchemas = append(matchSchemas, apiextensions.JSONSchemaProps{
+				Type: "object",
+				// Required is what actually makes the oneOf discriminate between targets: without
+				// it, a branch with nothing but an absent key is vacuously satisfied, and a
+				// constraint populating any single target's match block would match every branch.
+				Required: []string{name},
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					name: targets[name].MatchSchema(),
+				},
+			})
+		}
+		matchSchema = apiextensions.JSONSchemaProps{Type: "object", OneOf: matchSchemas}
+	}
+
+	enforcementActionSchema := apiextensions.JSONSchemaProps{Type: "string"}
+	if len(templ.Spec.EnforcementActions) > 0 {
+		enforcementActionSchema.Enum = make([]apiextensions.JSON, len(templ.Spec.EnforcementActions))
+		for i, action := range templ.Spec.EnforcementActions {
+			enforcementActionSchema.Enum[i] = action
+		}
+	}
+
 	props := map[string]apiextensions.JSONSchemaProps{
-		"match":             target.MatchSchema(),
-		"enforcementAction": apiextensions.JSONSchemaProps{Type: "string"},
+		"match":             matchSchema,
+		"enforcementAction": enforcementActionSchema,
 	}
 	if templ.Spec.CRD.Spec.Validation != nil && templ.Spec.CRD.Spec.Validation.OpenAPIV3Schema != nil {
 		internalSchema := &apiextensions.JSONSchemaProps{}
 		if err := h.scheme.Convert(templ.Spec.CRD.Spec.Validation.OpenAPIV3Schema, internalSchema, nil); err != nil {
 			return nil, err
 		}
+		if h.useStructuralSchema {
+			preserveUnknownFields := false
+			internalSchema.XPreserveUnknownFields = &preserveUnknownFields
+		}
 		props["parameters"] = *internalSchema
 	}
 	schema := &apiextensions.JSONSchemaProps{
+		Type: "object",
 		Properties: map[string]apiextensions.JSONSchemaProps{
 			"spec": apiextensions.JSONSchemaProps{
+				Type:       "object",
 				Properties: props,
 			},
 		},
@@ -59,18 +108,150 @@ func (h *crdHelper) createSchema(templ *templates.ConstraintTemplate, target Mat
 	return schema, nil
 }
 
+// matchSchemaNode returns the "spec.match" schema node of a CRD created by createCRD.
+func matchSchemaNode(crd *apiextensions.CustomResourceDefinition) (apiextensions.JSONSchemaProps, bool) {
+	if crd.Spec.Validation == nil || crd.Spec.Validation.OpenAPIV3Schema == nil {
+		return apiextensions.JSONSchemaProps{}, false
+	}
+	spec, ok := crd.Spec.Validation.OpenAPIV3Schema.Properties["spec"]
+	if !ok {
+		return apiextensions.JSONSchemaProps{}, false
+	}
+	match, ok := spec.Properties["match"]
+	if !ok {
+		return apiextensions.JSONSchemaProps{}, false
+	}
+	return match, true
+}
+
+// targetNamesFromCRD recovers the set of target handler names a CRD created by createCRD was
+// built to support, by reading back the branches of the "spec.match" oneOf. A single-target CRD
+// has no oneOf, so no names are returned for it.
+func targetNamesFromCRD(crd *apiextensions.CustomResourceDefinition) []string {
+	match, ok := matchSchemaNode(crd)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(match.OneOf))
+	for _, branch := range match.OneOf {
+		for name := range branch.Properties {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// matchSchemaForTarget returns the oneOf branch of a CRD's "spec.match" schema that belongs to
+// the named target, so callers can validate a constraint's match block against the one target
+// schema it actually resolved to instead of the shared oneOf.
+func matchSchemaForTarget(crd *apiextensions.CustomResourceDefinition, targetName string) (apiextensions.JSONSchemaProps, bool) {
+	match, ok := matchSchemaNode(crd)
+	if !ok {
+		return apiextensions.JSONSchemaProps{}, false
+	}
+	for _, branch := range match.OneOf {
+		if schema, ok := branch.Properties[targetName]; ok {
+			return schema, true
+		}
+	}
+	return apiextensions.JSONSchemaProps{}, false
+}
+
+// enforcementActionsFromCRD recovers the enforcementAction values a CRD created by createCRD was
+// built to accept, by reading back the "spec.enforcementAction" enum. Returns nil if the
+// template that produced crd didn't constrain enforcementAction to an enum.
+func enforcementActionsFromCRD(crd *apiextensions.CustomResourceDefinition) []string {
+	if crd.Spec.Validation == nil || crd.Spec.Validation.OpenAPIV3Schema == nil {
+		return nil
+	}
+	spec, ok := crd.Spec.Validation.OpenAPIV3Schema.Properties["spec"]
+	if !ok {
+		return nil
+	}
+	enforcementAction, ok := spec.Properties["enforcementAction"]
+	if !ok || len(enforcementAction.Enum) == 0 {
+		return nil
+	}
+	actions := make([]string, 0, len(enforcementAction.Enum))
+	for _, e := range enforcementAction.Enum {
+		if s, ok := e.(string); ok {
+			actions = append(actions, s)
+		}
+	}
+	return actions
+}
+
+func containsString(vals []string, target string) bool {
+	for _, v := range vals {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// matchedTarget returns the name of the single target whose match block the constraint
+// populates. A multi-target ConstraintTemplate's CRD exposes a "spec.match.<targetName>" block
+// per target. Returns "" with no error when the constraint populates none of them, and an error
+// when it populates more than one.
+func matchedTarget(cr *unstructured.Unstructured, targetNames []string) (string, error) {
+	match, found, err := unstructured.NestedMap(cr.Object, "spec", "match")
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", nil
+	}
+	var matched []string
+	for _, name := range targetNames {
+		if _, ok := match[name]; ok {
+			matched = append(matched, name)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return "", nil
+	case 1:
+		return matched[0], nil
+	default:
+		sort.Strings(matched)
+		return "", fmt.Errorf("constraint %s populates more than one target's match block: %v", cr.GetName(), matched)
+	}
+}
+
 // crdHelper builds the scheme for handling CRDs. It is necessary to build crdHelper at runtime as
 // modules are added to the CRD scheme builder during the init stage
 type crdHelper struct {
 	scheme *runtime.Scheme
+	// useStructuralSchema switches validateCR from the legacy JSONSchemaProps validator over to
+	// structural-schema validation (defaulting, list-type validation and pruning).
+	useStructuralSchema bool
 }
 
-func newCRDHelper() (*crdHelper, error) {
+// crdHelperOpt configures a crdHelper at construction time
+type crdHelperOpt func(*crdHelper)
+
+// UseStructuralSchema makes the crdHelper validate constraints as structural schemas rather than
+// with the legacy CRD validator, matching how modern CRDs are validated by the apiserver.
+func UseStructuralSchema() crdHelperOpt {
+	return func(h *crdHelper) {
+		h.useStructuralSchema = true
+	}
+}
+
+func newCRDHelper(opts ...crdHelperOpt) (*crdHelper, error) {
 	scheme := runtime.NewScheme()
 	if err := apiextensionsv1beta1.AddToScheme(scheme); err != nil {
 		return nil, err
 	}
-	return &crdHelper{scheme: scheme}, nil
+	if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	h := &crdHelper{scheme: scheme}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h, nil
 }
 
 // createCRD takes a template and a schema and converts it to a CRD
@@ -94,13 +275,18 @@ func (h *crdHelper) createCRD(
 				OpenAPIV3Schema: schema,
 			},
 			Scope:   "Cluster",
-			Version: v1beta1.SchemeGroupVersion.Version,
+			Version: v1.SchemeGroupVersion.Version,
 			Versions: []apiextensions.CustomResourceDefinitionVersion{
 				{
-					Name:    v1beta1.SchemeGroupVersion.Version,
+					Name:    v1.SchemeGroupVersion.Version,
 					Storage: true,
 					Served:  true,
 				},
+				{
+					Name:    v1beta1.SchemeGroupVersion.Version,
+					Storage: false,
+					Served:  true,
+				},
 				{
 					Name:    v1alpha1.SchemeGroupVersion.Version,
 					Storage: false,
@@ -123,9 +309,11 @@ func (h *crdHelper) createCRD(
 	return crd2, nil
 }
 
-// validateCRD calls the CRD package's validation on an internal representation of the CRD
+// validateCRD calls the CRD package's validation on an internal representation of the CRD. createCRD
+// makes v1 the storage version, so the CRD must be validated against v1's rules (which, unlike
+// v1beta1, require a structural OpenAPIV3Schema) rather than v1beta1's.
 func (h *crdHelper) validateCRD(crd *apiextensions.CustomResourceDefinition) error {
-	errors := apiextensionsvalidation.ValidateCustomResourceDefinition(crd, apiextensionsv1beta1.SchemeGroupVersion)
+	errors := apiextensionsvalidation.ValidateCustomResourceDefinition(crd, apiextensionsv1.SchemeGroupVersion)
 	if len(errors) > 0 {
 		return errors.ToAggregate()
 	}
@@ -134,12 +322,18 @@ func (h *crdHelper) validateCRD(crd *apiextensions.CustomResourceDefinition) err
 
 // validateCR validates the provided custom resource against its CustomResourceDefinition
 func (h *crdHelper) validateCR(cr *unstructured.Unstructured, crd *apiextensions.CustomResourceDefinition) error {
-	validator, _, err := validation.NewSchemaValidator(crd.Spec.Validation)
-	if err != nil {
-		return err
-	}
-	if err := validation.ValidateCustomResource(field.NewPath(""), cr, validator); err != nil {
-		return err.ToAggregate()
+	if h.useStructuralSchema {
+		if err := h.validateCRStructural(cr, crd); err != nil {
+			return err
+		}
+	} else {
+		validator, _, err := validation.NewSchemaValidator(crd.Spec.Validation)
+		if err != nil {
+			return err
+		}
+		if err := validation.ValidateCustomResource(field.NewPath(""), cr, validator); err != nil {
+			return err.ToAggregate()
+		}
 	}
 	if errs := apivalidation.IsDNS1123Subdomain(cr.GetName()); len(errs) != 0 {
 		return fmt.Errorf("Invalid Name: %s", strings.Join(errs, "\n"))
@@ -153,5 +347,89 @@ func (h *crdHelper) validateCR(cr *unstructured.Unstructured, crd *apiextensions
 	if !supportedVersions[cr.GroupVersionKind().Version] {
 		return fmt.Errorf("Wrong version for constraint %s. Have %s, supported: %v", cr.GetName(), cr.GroupVersionKind().Version, supportedVersions)
 	}
+	matchedName, err := matchedTarget(cr, targetNamesFromCRD(crd))
+	if err != nil {
+		return err
+	}
+	if matchedName != "" {
+		if err := h.validateMatchForTarget(cr, matchedName, crd); err != nil {
+			return err
+		}
+	}
+	if actions := enforcementActionsFromCRD(crd); len(actions) > 0 {
+		enforcementAction, found, err := unstructured.NestedString(cr.Object, "spec", "enforcementAction")
+		if err != nil {
+			return err
+		}
+		if !found || enforcementAction == "" {
+			if err := unstructured.SetNestedField(cr.Object, actions[0], "spec", "enforcementAction"); err != nil {
+				return err
+			}
+		} else if !containsString(actions, enforcementAction) {
+			return fmt.Errorf("Invalid enforcementAction %q for constraint %s. Supported: %v", enforcementAction, cr.GetName(), actions)
+		}
+	}
+	return nil
+}
+
+// validateMatchForTarget re-validates a constraint's "spec.match.<targetName>" block against the
+// single target schema it resolved to in matchedTarget, rather than relying solely on the
+// CRD-wide oneOf, so validation failures are reported against the target the constraint actually
+// targets instead of "matches none of the oneOf branches".
+func (h *crdHelper) validateMatchForTarget(cr *unstructured.Unstructured, targetName string, crd *apiextensions.CustomResourceDefinition) error {
+	targetSchema, ok := matchSchemaForTarget(crd, targetName)
+	if !ok {
+		return nil
+	}
+	matchValue, found, err := unstructured.NestedMap(cr.Object, "spec", "match", targetName)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	wrapper := &unstructured.Unstructured{Object: map[string]interface{}{
+		"match": matchValue,
+	}}
+	validator, _, err := validation.NewSchemaValidator(&apiextensions.CustomResourceValidation{
+		OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiextensions.JSONSchemaProps{
+				"match": targetSchema,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if errs := validation.ValidateCustomResource(field.NewPath("spec", "match", targetName), wrapper, validator); errs != nil {
+		return fmt.Errorf("invalid match block for target %q in constraint %s: %v", targetName, cr.GetName(), errs.ToAggregate())
+	}
+	return nil
+}
+
+// validateCRStructural runs the same structural-schema pipeline the Kubernetes apiserver runs
+// on incoming requests for a CRD with structural schemas: defaulting, list-type validation and
+// pruning of unknown fields, followed by the usual schema validation.
+func (h *crdHelper) validateCRStructural(cr *unstructured.Unstructured, crd *apiextensions.CustomResourceDefinition) error {
+	if crd.Spec.Validation == nil || crd.Spec.Validation.OpenAPIV3Schema == nil {
+		return nil
+	}
+	structural, err := structuralschema.NewStructural(crd.Spec.Validation.OpenAPIV3Schema)
+	if err != nil {
+		return err
+	}
+	structuraldefaulting.Default(cr.Object, structural)
+	if errs := structurallisttype.ValidateListSetsAndMaps(field.NewPath(""), structural, cr.Object); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	structuralpruning.Prune(cr.Object, structural, true)
+	validator, _, err := validation.NewSchemaValidator(crd.Spec.Validation)
+	if err != nil {
+		return err
+	}
+	if err := validation.ValidateCustomResource(field.NewPath(""), cr, validator); err != nil {
+		return err.ToAggregate()
+	}
 	return nil
 }
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/open-policy-agent/frameworks/constraint/pkg/core/templates"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type fakeMatchSchemaProvider struct {
+	schema apiextensions.JSONSchemaProps
+}
+
+func (f fakeMatchSchemaProvider) MatchSchema() apiextensions.JSONSchemaProps {
+	return f.schema
+}
+
+func TestCreateSchema_MultiTargetOneOfRequiresMatchedKey(t *testing.T) {
+	h := &crdHelper{}
+	templ := &templates.ConstraintTemplate{}
+	targets := map[string]MatchSchemaProvider{
+		"target.a": fakeMatchSchemaProvider{},
+		"target.b": fakeMatchSchemaProvider{},
+	}
+
+	schema, err := h.createSchema(templ, targets)
+	if err != nil {
+		t.Fatalf("createSchema returned error: %v", err)
+	}
+
+	match := schema.Properties["spec"].Properties["match"]
+	if len(match.OneOf) != 2 {
+		t.Fatalf("expected 2 oneOf branches, got %d", len(match.OneOf))
+	}
+	for _, branch := range match.OneOf {
+		if len(branch.Required) != 1 {
+			t.Errorf("expected each oneOf branch to require exactly its own target key, got %v", branch.Required)
+		}
+	}
+}
+
+func TestCreateSchema_SingleTargetUsesUnwrappedMatchSchema(t *testing.T) {
+	h := &crdHelper{}
+	templ := &templates.ConstraintTemplate{}
+	matchSchema := apiextensions.JSONSchemaProps{Type: "object", Required: []string{"kinds"}}
+	targets := map[string]MatchSchemaProvider{
+		"target.a": fakeMatchSchemaProvider{schema: matchSchema},
+	}
+
+	schema, err := h.createSchema(templ, targets)
+	if err != nil {
+		t.Fatalf("createSchema returned error: %v", err)
+	}
+
+	match := schema.Properties["spec"].Properties["match"]
+	if match.OneOf != nil {
+		t.Fatalf("expected a single-target template to keep the unwrapped match schema, got oneOf branches: %v", match.OneOf)
+	}
+	if !reflect.DeepEqual(match, matchSchema) {
+		t.Errorf("expected match schema to be the target's schema directly, got %+v, want %+v", match, matchSchema)
+	}
+}
+
+func TestMatchedTarget(t *testing.T) {
+	targetNames := []string{"target.a", "target.b"}
+
+	single := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"match": map[string]interface{}{
+				"target.a": map[string]interface{}{},
+			},
+		},
+	}}
+	name, err := matchedTarget(single, targetNames)
+	if err != nil {
+		t.Fatalf("matchedTarget returned error: %v", err)
+	}
+	if name != "target.a" {
+		t.Errorf("expected target.a, got %q", name)
+	}
+
+	ambiguous := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"match": map[string]interface{}{
+				"target.a": map[string]interface{}{},
+				"target.b": map[string]interface{}{},
+			},
+		},
+	}}
+	if _, err := matchedTarget(ambiguous, targetNames); err == nil {
+		t.Error("expected error for constraint populating more than one target's match block")
+	}
+
+	none := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	name, err = matchedTarget(none, targetNames)
+	if err != nil {
+		t.Fatalf("matchedTarget returned error: %v", err)
+	}
+	if name != "" {
+		t.Errorf("expected empty target name when no match block is set, got %q", name)
+	}
+}
+
+func TestValidateCRStructural_PrunesUnknownFields(t *testing.T) {
+	crd := &apiextensions.CustomResourceDefinition{
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Names: apiextensions.CustomResourceDefinitionNames{Kind: "FakeConstraint"},
+			Validation: &apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"spec": {
+							Type: "object",
+							Properties: map[string]apiextensions.JSONSchemaProps{
+								"parameters": {
+									Type: "object",
+									Properties: map[string]apiextensions.JSONSchemaProps{
+										"allowed": {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	h := &crdHelper{useStructuralSchema: true}
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "constraints.gatekeeper.sh/v1",
+		"kind":       "FakeConstraint",
+		"metadata":   map[string]interface{}{"name": "test"},
+		"spec": map[string]interface{}{
+			"parameters": map[string]interface{}{
+				"allowed": "yes",
+				"unknown": "should-be-pruned",
+			},
+		},
+	}}
+
+	if err := h.validateCRStructural(cr, crd); err != nil {
+		t.Fatalf("validateCRStructural returned error: %v", err)
+	}
+
+	params, _, _ := unstructured.NestedMap(cr.Object, "spec", "parameters")
+	if _, ok := params["unknown"]; ok {
+		t.Errorf("expected unknown field to be pruned, got %v", params)
+	}
+	if params["allowed"] != "yes" {
+		t.Errorf("expected known field to survive pruning, got %v", params)
+	}
+}
+
+func TestEnforcementActionsFromCRD(t *testing.T) {
+	crd := &apiextensions.CustomResourceDefinition{
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Validation: &apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"spec": {
+							Type: "object",
+							Properties: map[string]apiextensions.JSONSchemaProps{
+								"enforcementAction": {
+									Type: "string",
+									Enum: []apiextensions.JSON{"deny", "dryrun"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actions := enforcementActionsFromCRD(crd)
+	if len(actions) != 2 || actions[0] != "deny" || actions[1] != "dryrun" {
+		t.Fatalf("expected [deny dryrun], got %v", actions)
+	}
+
+	if !containsString(actions, "deny") {
+		t.Errorf("expected %q to be a supported enforcementAction", "deny")
+	}
+	if containsString(actions, "block") {
+		t.Errorf("did not expect %q to be a supported enforcementAction", "block")
+	}
+
+	// A template that never constrained enforcementAction to an enum shouldn't force a default
+	// or reject otherwise-valid values.
+	noEnum := &apiextensions.CustomResourceDefinition{}
+	if actions := enforcementActionsFromCRD(noEnum); actions != nil {
+		t.Errorf("expected no enforcementActions for a CRD without the enum, got %v", actions)
+	}
+}
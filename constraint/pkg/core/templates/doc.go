@@ -0,0 +1,4 @@
+// Package templates holds the internal, unversioned representation of ConstraintTemplate. Every
+// versioned API under apis/templates (v1alpha1, v1beta1, v1) converts to and from this package
+// rather than to and from each other, so it acts as the hub of the conversion graph.
+package templates
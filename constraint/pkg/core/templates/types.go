@@ -0,0 +1,72 @@
+package templates
+
+import (
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConstraintTemplate is the internal representation of the Schema for the constrainttemplates API
+type ConstraintTemplate struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   ConstraintTemplateSpec
+	Status ConstraintTemplateStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConstraintTemplateList contains a list of ConstraintTemplate
+type ConstraintTemplateList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []ConstraintTemplate
+}
+
+// ConstraintTemplateSpec defines the desired state of ConstraintTemplate
+type ConstraintTemplateSpec struct {
+	CRD     CRD
+	Targets map[string]Target
+	// EnforcementActions lists the enforcementAction values constraints created from this
+	// ConstraintTemplate are allowed to use (e.g. "deny", "dryrun", "warn", "scoped"). The CRD
+	// emitted for the template enforces this as an OpenAPI enum, and the first entry is used as
+	// the default when a constraint doesn't set spec.enforcementAction.
+	EnforcementActions []string
+}
+
+// CRD guides the construction of the CRD that will be created from this ConstraintTemplate
+type CRD struct {
+	Spec CRDSpec
+}
+
+// CRDSpec describes the CRD that will be created from this ConstraintTemplate
+type CRDSpec struct {
+	Names      CRDNames
+	Validation *Validation
+}
+
+// CRDNames are the names used to describe the CRD
+type CRDNames struct {
+	Kind string
+}
+
+// Validation describes the schema used to validate constraints created as a result of this
+// ConstraintTemplate
+type Validation struct {
+	// OpenAPIV3Schema is the schema used to validate the parameters of constraints created from
+	// this ConstraintTemplate, expressed in the internal CustomResourceDefinition schema format.
+	OpenAPIV3Schema *apiextensions.JSONSchemaProps
+}
+
+// Target describes the target that this ConstraintTemplate applies to
+type Target struct {
+	Target string
+	Rego   string
+}
+
+// ConstraintTemplateStatus defines the observed state of ConstraintTemplate
+type ConstraintTemplateStatus struct {
+	Created bool
+}